@@ -1,12 +1,22 @@
 package main
 
 import (
+	"context"
 	"log"
+	"net"
 	"net/http"
 	"os"
 	"time"
 
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"github.com/prometheus/client_golang/prometheus/promhttp"
+	"go.opentelemetry.io/contrib/instrumentation/net/http/otelhttp"
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+
 	"beerbot-go/internal/api"
+	"beerbot-go/internal/grpcapi"
+	pb "beerbot-go/internal/pb/decisionv1"
 )
 
 func main() {
@@ -15,8 +25,46 @@ func main() {
 		log.Fatalf("config invalid: %v", err)
 	}
 
+	shutdownTracing, err := api.SetupTracing(context.Background(), cfg)
+	if err != nil {
+		log.Fatalf("setup tracing: %v", err)
+	}
+	defer func() {
+		if err := shutdownTracing(context.Background()); err != nil {
+			log.Printf("tracing shutdown: %v", err)
+		}
+	}()
+
+	store, err := cfg.NewStore()
+	if err != nil {
+		log.Fatalf("init state store: %v", err)
+	}
+
+	grpcPort := getenv("GRPC_PORT", "9090")
+	grpcSrv := grpc.NewServer()
+	pb.RegisterDecisionServer(grpcSrv, grpcapi.NewServerWithStore(cfg, store))
+
+	grpcLis, err := net.Listen("tcp", ":"+grpcPort)
+	if err != nil {
+		log.Fatalf("grpc listen: %v", err)
+	}
+	go func() {
+		log.Printf("BeerBot gRPC listening on :%s", grpcPort)
+		log.Fatal(grpcSrv.Serve(grpcLis))
+	}()
+
+	// The gateway dials the gRPC server in-process so /v1/decision/* mirrors
+	// the existing JSON envelopes without duplicating the decision logic.
+	gwMux := runtime.NewServeMux()
+	dialOpts := []grpc.DialOption{grpc.WithTransportCredentials(insecure.NewCredentials())}
+	if err := pb.RegisterDecisionHandlerFromEndpoint(context.Background(), gwMux, "localhost:"+grpcPort, dialOpts); err != nil {
+		log.Fatalf("register gateway: %v", err)
+	}
+
 	mux := http.NewServeMux()
-	mux.Handle("/api/decision", api.NewDecisionHandlerBuffered(cfg))
+	mux.Handle("/api/decision", otelhttp.NewHandler(api.NewDecisionHandlerBufferedWithStore(cfg, store), "decision"))
+	mux.Handle("/v1/decision/", gwMux)
+	mux.Handle("/metrics", promhttp.Handler())
 
 	// Optional health endpoint (handy for Render checks)
 	mux.HandleFunc("/healthz", func(w http.ResponseWriter, r *http.Request) {
@@ -24,10 +72,7 @@ func main() {
 		_, _ = w.Write([]byte("ok"))
 	})
 
-	port := os.Getenv("PORT")
-	if port == "" {
-		port = "8080"
-	}
+	port := getenv("PORT", "8080")
 
 	srv := &http.Server{
 		Addr:              ":" + port,
@@ -41,3 +86,11 @@ func main() {
 	log.Printf("BeerBot listening on :%s (algo=%s, version=%s)", port, cfg.AlgorithmName, cfg.Version)
 	log.Fatal(srv.ListenAndServe())
 }
+
+func getenv(key, def string) string {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	return v
+}
@@ -1,12 +1,17 @@
 package api
 
 import (
+	"context"
 	"encoding/json"
 	"errors"
 	"log"
 	"net/http"
 	"regexp"
 	"strings"
+	"time"
+
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/codes"
 
 	"beerbot-go/internal/decision"
 )
@@ -30,6 +35,25 @@ type Config struct {
 	SafetyStock int // e.g. +10
 	MAWindow    int // moving average window for demand smoothing (>=1)
 	MaxOrder    int // 0 means "no cap"
+
+	// GlassBox tuning knobs (base-stock / order-up-to policy)
+	GlassBoxAlpha        float64 // demand smoothing weight, default 0.25
+	GlassBoxBeta         float64 // variance smoothing weight, default 0.25
+	GlassBoxLeadTime     int     // L, default 2
+	GlassBoxReviewPeriod int     // R, default 1
+	GlassBoxServiceZ     float64 // service-level factor z, default 1.65 (~95%)
+
+	// Telemetry (OpenTelemetry tracing + Prometheus metrics). OTLP is the
+	// only exporter supported — see SetupTracing.
+	TracingEnabled  bool
+	OTelServiceName string
+	OTelEndpoint    string
+
+	// Per-seed learning state persistence (see decision.Store)
+	StateBackend string // "mem", "file", or "nats"
+	StateDir     string // used when StateBackend=="file"
+	StateTTL     time.Duration
+	NATSURL      string // used when StateBackend=="nats"
 }
 
 func ConfigFromEnv() Config {
@@ -38,14 +62,135 @@ func ConfigFromEnv() Config {
 		AlgorithmName:    getenv("ALGORITHM_NAME", "BeerBot_BlackBox"),
 		Version:          getenv("VERSION", "v1.0.0"),
 		SupportsBlackBox: true,
-		SupportsGlassBox: false,
+		SupportsGlassBox: getenv("SUPPORTS_GLASSBOX", "false") == "true",
 		SafetyStock:      getenvInt("SAFETY_STOCK", 10),
 		MAWindow:         getenvInt("MA_WINDOW", 4),
 		MaxOrder:         getenvInt("MAX_ORDER", 0),
+
+		GlassBoxAlpha:        getenvFloat("GLASSBOX_ALPHA", 0.25),
+		GlassBoxBeta:         getenvFloat("GLASSBOX_BETA", 0.25),
+		GlassBoxLeadTime:     getenvInt("GLASSBOX_LEAD_TIME", 2),
+		GlassBoxReviewPeriod: getenvInt("GLASSBOX_REVIEW_PERIOD", 1),
+		GlassBoxServiceZ:     getenvFloat("GLASSBOX_SERVICE_Z", 1.65),
+
+		TracingEnabled:  getenv("OTEL_ENABLED", "false") == "true",
+		OTelServiceName: getenv("OTEL_SERVICE_NAME", "beerbot-go"),
+		OTelEndpoint:    getenv("OTEL_EXPORTER_ENDPOINT", "localhost:4317"),
+
+		StateBackend: getenv("STATE_BACKEND", "mem"),
+		StateDir:     getenv("STATE_DIR", "./.beerbot-state"),
+		StateTTL:     time.Duration(getenvInt("STATE_TTL_SECONDS", 3600)) * time.Second,
+		NATSURL:      getenv("NATS_URL", "nats://127.0.0.1:4222"),
 	}
 	return cfg
 }
 
+// NewStore builds the decision.Store selected by cfg.StateBackend.
+func (c Config) NewStore() (decision.Store, error) {
+	switch c.StateBackend {
+	case "file":
+		return decision.NewFileStore(c.StateDir, c.StateTTL)
+	case "nats":
+		return decision.NewNATSStore(c.NATSURL, c.StateTTL)
+	default:
+		return decision.NewMemStore(c.StateTTL), nil
+	}
+}
+
+// glassBoxConfig builds a decision.GlassBoxConfig from the env-tunable
+// fields on Config.
+func (c Config) glassBoxConfig() decision.GlassBoxConfig {
+	return decision.GlassBoxConfig{
+		Alpha:        c.GlassBoxAlpha,
+		Beta:         c.GlassBoxBeta,
+		LeadTime:     c.GlassBoxLeadTime,
+		ReviewPeriod: c.GlassBoxReviewPeriod,
+		ServiceZ:     c.GlassBoxServiceZ,
+	}
+}
+
+// glassBoxOrder picks the order for a single role in glassbox mode. When
+// store is nil it recomputes from the full history (decision.GlassBoxOrder);
+// otherwise it resumes the role's learning state for seed and advances it
+// by one week (decision.GlassBoxOrderIncremental), persisting the result.
+//
+// A store miss with more than one week of history on hand means the seed's
+// state was evicted mid-game (its TTL expired) rather than this being the
+// role's first-ever observation: reseeding dHat from only the current
+// week would silently under-smooth relative to continuous computation, so
+// that case falls back to a full-history recompute instead.
+func glassBoxOrder(store decision.Store, seed int, role string, history []decision.RoleState, ordersHist []int, last decision.RoleState, pipeline int, cfg Config) int {
+	if store == nil {
+		return decision.GlassBoxOrder(history, ordersHist, cfg.glassBoxConfig())
+	}
+
+	state, ok := store.Get(seed)
+	if state.Roles == nil {
+		state.Roles = make(map[string]decision.RoleLearningState)
+	}
+
+	prev, hasPrev := state.Roles[role]
+	if (!ok || !hasPrev) && len(history) > 1 {
+		log.Printf("glassbox: store miss for seed=%d role=%s with history_len=%d, recomputing from full history", seed, role, len(history))
+		order, recovered := decision.GlassBoxOrderWithState(history, ordersHist, cfg.glassBoxConfig())
+		state.Roles[role] = recovered
+		if err := store.Put(seed, state); err != nil {
+			log.Printf("store.Put seed=%d role=%s: %v", seed, role, err)
+		}
+		return order
+	}
+
+	order, next := decision.GlassBoxOrderIncremental(prev, last, pipeline, cfg.glassBoxConfig())
+	state.Roles[role] = next
+	if err := store.Put(seed, state); err != nil {
+		log.Printf("store.Put seed=%d role=%s: %v", seed, role, err)
+	}
+	return order
+}
+
+// ComputeRoleOrders runs the configured policy (blackbox or, when cfg
+// allows it, glassbox) for every role given weeks of history, emitting the
+// same per-role tracing spans and Prometheus metrics regardless of which
+// transport called it. internal/api's HTTP handler and
+// internal/grpcapi.Server both call this one function so the gRPC/gateway
+// surface can't drift from the HTTP handler's behavior.
+func ComputeRoleOrders(ctx context.Context, cfg Config, store decision.Store, mode string, seed int, weeks []decision.WeekState) map[string]int {
+	orders := make(map[string]int, 4)
+	for _, role := range []string{"retailer", "wholesaler", "distributor", "factory"} {
+		history := decision.ExtractRoleHistory(weeks, role)
+		if len(history) == 0 {
+			orders[role] = 10
+			continue
+		}
+
+		ordersHist := decision.ExtractRoleOrders(weeks, role)
+		last := history[len(history)-1]
+		pipeline := decision.ReconstructPipeline(history, ordersHist)
+
+		var o int
+		if mode == "glassbox" && cfg.SupportsGlassBox {
+			o = glassBoxOrder(store, seed, role, history, ordersHist, last, pipeline, cfg)
+		} else {
+			o = decision.BlackBoxOrderWithPipeline(history, ordersHist, cfg.SafetyStock, cfg.MAWindow)
+		}
+		clamped := cfg.MaxOrder > 0 && o > cfg.MaxOrder
+		if clamped {
+			o = cfg.MaxOrder
+		}
+		orders[role] = o
+
+		log.Printf("[ROLE] %s inv=%d back=%d in_orders=%d arriving=%d -> order=%d",
+			role, last.Inventory, last.Backlog, last.IncomingOrders, last.ArrivingShipments, o)
+
+		_, roleSpan := tracer().Start(ctx, "decision.role")
+		roleSpan.SetAttributes(roleSpanAttributes(role, last.Inventory, last.Backlog, pipeline, o)...)
+		roleSpan.End()
+
+		recordOrder(role, o, clamped)
+	}
+	return orders
+}
+
 func (c Config) Validate() error {
 	if !strings.HasSuffix(strings.ToLower(c.StudentEmail), "@taltech.ee") {
 		return errors.New("STUDENT_EMAIL must end with @taltech.ee")
@@ -72,6 +217,11 @@ func (c Config) Validate() error {
 	if c.MaxOrder < 0 {
 		return errors.New("MAX_ORDER must be >= 0")
 	}
+	switch c.StateBackend {
+	case "mem", "file", "nats":
+	default:
+		return errors.New("STATE_BACKEND must be one of mem, file, nats")
+	}
 	return nil
 }
 
@@ -161,10 +311,26 @@ func NewDecisionHandler(cfg Config) http.Handler {
 
 // NewDecisionHandlerBuffered is the actual handler used by main.go.
 // It reads the body bytes once so we can decode twice (handshake detection + weekly request).
+// Glass-box learning state is not persisted across requests; use
+// NewDecisionHandlerBufferedWithStore for that.
 func NewDecisionHandlerBuffered(cfg Config) http.Handler {
+	return NewDecisionHandlerBufferedWithStore(cfg, nil)
+}
+
+// NewDecisionHandlerBufferedWithStore is NewDecisionHandlerBuffered with a
+// decision.Store plugged in: when store is non-nil and req.Mode is
+// "glassbox", the smoothed demand/variance for each role is resumed from
+// the seed's last Put instead of being recomputed from the full history on
+// every call.
+func NewDecisionHandlerBufferedWithStore(cfg Config, store decision.Store) http.Handler {
 	return http.HandlerFunc(func(w http.ResponseWriter, r *http.Request) {
+		start := time.Now()
 		w.Header().Set("Content-Type", "application/json")
 
+		ctx, span := tracer().Start(r.Context(), "decision.request")
+		defer span.End()
+		r = r.WithContext(ctx)
+
 		if r.Method != http.MethodPost {
 			_ = json.NewEncoder(w).Encode(defaultOrders())
 			return
@@ -172,9 +338,11 @@ func NewDecisionHandlerBuffered(cfg Config) http.Handler {
 
 		body, err := readAllLimited(r, 1<<20) // 1MB max
 		if err != nil {
+			span.SetStatus(codes.Error, err.Error())
 			_ = json.NewEncoder(w).Encode(defaultOrders())
 			return
 		}
+		bodySize.Observe(float64(len(body)))
 
 		var hs handshakeRequest
 		_ = json.Unmarshal(body, &hs)
@@ -202,26 +370,16 @@ func NewDecisionHandlerBuffered(cfg Config) http.Handler {
 		}
 		log.Printf("[WEEK] mode=%s week=%d/%d seed=%d weeks_len=%d",
 			req.Mode, req.Week, req.WeeksTotal, req.Seed, len(req.Weeks))
+		span.SetAttributes(
+			attribute.String("mode", req.Mode),
+			attribute.Int("week", req.Week),
+			attribute.Int("weeks_total", req.WeeksTotal),
+			attribute.Int("seed", req.Seed),
+		)
 
-		// We only support blackbox decisions, but we can still accept mode field.
-		// In blackbox, each role order depends only on that role's own history.
-		orders := make(map[string]int, 4)
-		for _, role := range []string{"retailer", "wholesaler", "distributor", "factory"} {
-			history := decision.ExtractRoleHistory(req.Weeks, role)
-			log.Printf("role=%s history_len=%d", role, len(history))
-
-			ordersHist := decision.ExtractRoleOrders(req.Weeks, role)
-			o := decision.BlackBoxOrderWithPipeline(history, ordersHist, cfg.SafetyStock, cfg.MAWindow)
-			if cfg.MaxOrder > 0 && o > cfg.MaxOrder {
-				o = cfg.MaxOrder
-			}
-			orders[role] = o
-
-			last := history[len(history)-1]
-			log.Printf("[ROLE] %s inv=%d back=%d in_orders=%d arriving=%d -> order=%d",
-				role, last.Inventory, last.Backlog, last.IncomingOrders, last.ArrivingShipments, o)
-		}
+		orders := ComputeRoleOrders(ctx, cfg, store, req.Mode, req.Seed, req.Weeks)
 
+		requestLatency.WithLabelValues(req.Mode).Observe(time.Since(start).Seconds())
 		_ = json.NewEncoder(w).Encode(weeklyResponse{Orders: orders})
 	})
 }
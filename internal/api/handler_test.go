@@ -0,0 +1,75 @@
+package api
+
+import (
+	"testing"
+	"time"
+
+	"beerbot-go/internal/decision"
+)
+
+func testGlassBoxCfg() Config {
+	return Config{
+		GlassBoxAlpha:        0.25,
+		GlassBoxBeta:         0.25,
+		GlassBoxLeadTime:     2,
+		GlassBoxReviewPeriod: 1,
+		GlassBoxServiceZ:     1.65,
+	}
+}
+
+// glassBoxStepHistory mirrors internal/decision's Sterman-step fixture: one
+// week of steady demand (4) followed by a step up to 8.
+func glassBoxStepHistory() []decision.RoleState {
+	return []decision.RoleState{
+		{Inventory: 12, Backlog: 0, IncomingOrders: 4, ArrivingShipments: 4},
+		{Inventory: 8, Backlog: 0, IncomingOrders: 8, ArrivingShipments: 4},
+	}
+}
+
+func TestGlassBoxOrderFirstObservationUsesIncrementalSeed(t *testing.T) {
+	cfg := testGlassBoxCfg()
+	history := glassBoxStepHistory()[:1]
+	store := decision.NewMemStore(0)
+
+	pipeline := decision.ReconstructPipeline(history, nil)
+	got := glassBoxOrder(store, 1, "retailer", history, nil, history[0], pipeline, cfg)
+
+	want, _ := decision.GlassBoxOrderIncremental(decision.RoleLearningState{}, history[0], pipeline, cfg.glassBoxConfig())
+	if got != want {
+		t.Fatalf("first observation: glassBoxOrder = %d, want %d (GlassBoxOrderIncremental from a zero state)", got, want)
+	}
+}
+
+func TestGlassBoxOrderRecoversFromTTLEviction(t *testing.T) {
+	cfg := testGlassBoxCfg()
+	history := glassBoxStepHistory()
+	orders := []int{4}
+	store := decision.NewMemStore(5 * time.Millisecond)
+
+	// Week 1: populates the seed's learning state.
+	week1Pipeline := decision.ReconstructPipeline(history[:1], nil)
+	glassBoxOrder(store, 1, "retailer", history[:1], nil, history[0], week1Pipeline, cfg)
+
+	// Let the TTL evict it mid-game, as it would between two real requests
+	// spaced further apart than StateTTL.
+	time.Sleep(10 * time.Millisecond)
+	if _, ok := store.Get(1); ok {
+		t.Fatalf("test setup: expected state to have expired before week 2")
+	}
+
+	week2Pipeline := decision.ReconstructPipeline(history, orders)
+	got := glassBoxOrder(store, 1, "retailer", history, orders, history[1], week2Pipeline, cfg)
+
+	want := decision.GlassBoxOrder(history, orders, cfg.glassBoxConfig())
+	if got != want {
+		t.Fatalf("glassBoxOrder after TTL eviction = %d, want %d (full-history recompute, not a fresh incremental seed)", got, want)
+	}
+
+	state, ok := store.Get(1)
+	if !ok {
+		t.Fatalf("expected the recovered state to be persisted for the next call")
+	}
+	if state.Roles["retailer"].UpdatedAt.IsZero() {
+		t.Fatalf("expected a non-zero UpdatedAt on the recovered state")
+	}
+}
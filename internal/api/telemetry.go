@@ -0,0 +1,102 @@
+package api
+
+import (
+	"context"
+	"log"
+
+	"github.com/prometheus/client_golang/prometheus"
+	"github.com/prometheus/client_golang/prometheus/promauto"
+	"go.opentelemetry.io/otel"
+	"go.opentelemetry.io/otel/attribute"
+	"go.opentelemetry.io/otel/exporters/otlp/otlptrace/otlptracegrpc"
+	"go.opentelemetry.io/otel/sdk/resource"
+	sdktrace "go.opentelemetry.io/otel/sdk/trace"
+	semconv "go.opentelemetry.io/otel/semconv/v1.21.0"
+	"go.opentelemetry.io/otel/trace"
+)
+
+// tracerName identifies spans emitted by this package.
+const tracerName = "beerbot-go/internal/api"
+
+var (
+	requestLatency = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "beerbot_decision_request_duration_seconds",
+		Help:    "Latency of /api/decision requests.",
+		Buckets: prometheus.DefBuckets,
+	}, []string{"mode"})
+
+	bodySize = promauto.NewHistogram(prometheus.HistogramOpts{
+		Name:    "beerbot_decision_request_body_bytes",
+		Help:    "Decoded size of incoming /api/decision request bodies.",
+		Buckets: prometheus.ExponentialBuckets(64, 2, 12),
+	})
+
+	orderMagnitude = promauto.NewHistogramVec(prometheus.HistogramOpts{
+		Name:    "beerbot_decision_order_magnitude",
+		Help:    "Order quantity chosen per role.",
+		Buckets: prometheus.LinearBuckets(0, 5, 20),
+	}, []string{"role"})
+
+	clampEvents = promauto.NewCounterVec(prometheus.CounterOpts{
+		Name: "beerbot_decision_order_clamped_total",
+		Help: "Number of times an order was clamped down to MaxOrder.",
+	}, []string{"role"})
+)
+
+// SetupTracing configures the global OTel tracer provider from cfg and
+// returns a shutdown func to flush spans on exit. If cfg disables tracing
+// it returns a no-op shutdown.
+func SetupTracing(ctx context.Context, cfg Config) (func(context.Context) error, error) {
+	if !cfg.TracingEnabled {
+		return func(context.Context) error { return nil }, nil
+	}
+
+	res, err := resource.Merge(resource.Default(), resource.NewSchemaless(
+		semconv.ServiceName(cfg.OTelServiceName),
+	))
+	if err != nil {
+		return nil, err
+	}
+
+	// OTLP is the only exporter we support: OTel dropped the standalone
+	// Jaeger exporter (archived upstream) in favor of every backend,
+	// Jaeger included, speaking OTLP directly.
+	exporter, err := otlptracegrpc.New(ctx, otlptracegrpc.WithEndpoint(cfg.OTelEndpoint), otlptracegrpc.WithInsecure())
+	if err != nil {
+		return nil, err
+	}
+
+	tp := sdktrace.NewTracerProvider(
+		sdktrace.WithBatcher(exporter),
+		sdktrace.WithResource(res),
+	)
+	otel.SetTracerProvider(tp)
+
+	log.Printf("otel tracing enabled: exporter=otlp endpoint=%s service=%s", cfg.OTelEndpoint, cfg.OTelServiceName)
+	return tp.Shutdown, nil
+}
+
+func tracer() trace.Tracer {
+	return otel.Tracer(tracerName)
+}
+
+// recordOrder emits the order-magnitude histogram and, when clamped,
+// increments the clamp counter for role.
+func recordOrder(role string, order int, clamped bool) {
+	orderMagnitude.WithLabelValues(role).Observe(float64(order))
+	if clamped {
+		clampEvents.WithLabelValues(role).Inc()
+	}
+}
+
+// roleSpanAttributes builds the attribute set attached to each per-role
+// child span.
+func roleSpanAttributes(role string, inventory, backlog, pipeline, order int) []attribute.KeyValue {
+	return []attribute.KeyValue{
+		attribute.String("role", role),
+		attribute.Int("inventory", inventory),
+		attribute.Int("backlog", backlog),
+		attribute.Int("pipeline", pipeline),
+		attribute.Int("order", order),
+	}
+}
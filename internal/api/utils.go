@@ -27,6 +27,18 @@ func getenvInt(key string, def int) int {
 	return n
 }
 
+func getenvFloat(key string, def float64) float64 {
+	v := os.Getenv(key)
+	if v == "" {
+		return def
+	}
+	f, err := strconv.ParseFloat(v, 64)
+	if err != nil {
+		return def
+	}
+	return f
+}
+
 func readAllLimited(r *http.Request, max int64) ([]byte, error) {
 	rr := http.MaxBytesReader(nil, r.Body, max)
 	defer rr.Close()
@@ -16,21 +16,11 @@ func BlackBoxOrderWithPipeline(roleHistory []RoleState, roleOrders []int, safety
 	last := roleHistory[len(roleHistory)-1]
 	forecast := movingAverageIncomingOrders(roleHistory, window)
 
-	// pipeline = sum(orders) - sum(arriving_shipments)
-	pipeline := 0
-	for i := 0; i < len(roleHistory); i++ {
-		if i < len(roleOrders) {
-			pipeline += roleOrders[i]
-		}
-		pipeline -= roleHistory[i].ArrivingShipments
-		if pipeline < 0 {
-			pipeline = 0
-		}
-	}
+	pipeline := ReconstructPipeline(roleHistory, roleOrders)
 
 	// assume lead time L=2 (common Beer Game)
 	L := 2
-	targetPosition := forecast * (L + 1)
+	targetPosition := forecast*(L+1) + safetyStock
 	inventoryPosition := last.Inventory - last.Backlog + pipeline
 
 	order := targetPosition - inventoryPosition
@@ -0,0 +1,137 @@
+package decision
+
+import (
+	"math"
+	"time"
+)
+
+// GlassBoxConfig holds the tunable parameters for GlassBoxOrder. Defaults
+// mirror a classic Beer Game base-stock / order-up-to policy.
+type GlassBoxConfig struct {
+	Alpha        float64 // demand smoothing weight, default 0.25
+	Beta         float64 // variance smoothing weight, default 0.25
+	LeadTime     int     // L, default 2
+	ReviewPeriod int     // R, default 1
+	ServiceZ     float64 // service-level factor z, default 1.65 (~95%)
+}
+
+// DefaultGlassBoxConfig returns the defaults used when Config doesn't
+// override them.
+func DefaultGlassBoxConfig() GlassBoxConfig {
+	return GlassBoxConfig{
+		Alpha:        0.25,
+		Beta:         0.25,
+		LeadTime:     2,
+		ReviewPeriod: 1,
+		ServiceZ:     1.65,
+	}
+}
+
+// GlassBoxOrder implements a base-stock (order-up-to) policy:
+//
+//   - Smooth demand with exponential smoothing: dHat = alpha*incoming + (1-alpha)*dHatPrev.
+//   - Track demand variance with its own smoothing: sigma2 = (1-beta)*sigma2Prev + beta*(incoming-dHat)^2.
+//   - Set order-up-to level S = (L+R)*dHat + z*sigma*sqrt(L+R).
+//   - Order max(0, S - inventory position), where inventory position is
+//     Inventory - Backlog + pipeline (pipeline reconstructed from
+//     roleOrders minus ArrivingShipments, same as BlackBoxOrderWithPipeline).
+//
+// This recomputes the smoothed demand and variance from the full history on
+// every call; GlassBoxOrderIncremental, with a decision.Store (see store.go),
+// resumes them across requests instead.
+func GlassBoxOrder(roleHistory []RoleState, roleOrders []int, cfg GlassBoxConfig) int {
+	order, _ := GlassBoxOrderWithState(roleHistory, roleOrders, cfg)
+	return order
+}
+
+// GlassBoxOrderWithState is GlassBoxOrder, but also returns the resulting
+// RoleLearningState. Store-backed callers use this to recover from a store
+// miss mid-game (e.g. a TTL eviction): recompute from the full history
+// like GlassBoxOrder, then persist the returned state so the next call can
+// resume incrementally via GlassBoxOrderIncremental instead of replaying
+// history on every request again.
+func GlassBoxOrderWithState(roleHistory []RoleState, roleOrders []int, cfg GlassBoxConfig) (int, RoleLearningState) {
+	if len(roleHistory) == 0 {
+		return 10, RoleLearningState{}
+	}
+
+	dHat, sigma2 := smoothedDemand(roleHistory, cfg.Alpha, cfg.Beta)
+	sigma := math.Sqrt(sigma2)
+
+	horizon := float64(cfg.LeadTime + cfg.ReviewPeriod)
+	s := horizon*dHat + cfg.ServiceZ*sigma*math.Sqrt(horizon)
+
+	pipeline := ReconstructPipeline(roleHistory, roleOrders)
+	last := roleHistory[len(roleHistory)-1]
+	inventoryPosition := float64(last.Inventory-last.Backlog+pipeline)
+
+	order := int(math.Round(s - inventoryPosition))
+	if order < 0 {
+		order = 0
+	}
+	return order, RoleLearningState{DHat: dHat, Sigma2: sigma2, LastS: s, UpdatedAt: time.Now()}
+}
+
+// smoothedDemand runs exponential smoothing for the mean and variance of
+// incoming orders across the full history, seeding dHat with the first
+// observed demand so early weeks don't start from zero.
+func smoothedDemand(history []RoleState, alpha, beta float64) (dHat, sigma2 float64) {
+	if alpha <= 0 {
+		alpha = 0.25
+	}
+	if beta <= 0 {
+		beta = 0.25
+	}
+
+	dHat = float64(history[0].IncomingOrders)
+	sigma2 = 0
+
+	for _, rs := range history {
+		incoming := float64(rs.IncomingOrders)
+		dHat = alpha*incoming + (1-alpha)*dHat
+		err := incoming - dHat
+		sigma2 = (1-beta)*sigma2 + beta*err*err
+	}
+	return dHat, sigma2
+}
+
+// GlassBoxOrderIncremental is the Store-backed counterpart to
+// GlassBoxOrder: instead of recomputing the smoothed demand and variance
+// from the full week-by-week history on every call, it advances prev by a
+// single observation (the latest week's incoming orders) and returns the
+// updated learning state alongside the order. Callers persist the
+// returned RoleLearningState via Store.Put so the next POST for the same
+// seed can resume from it instead of replaying history.
+func GlassBoxOrderIncremental(prev RoleLearningState, last RoleState, pipeline int, cfg GlassBoxConfig) (int, RoleLearningState) {
+	alpha, beta := cfg.Alpha, cfg.Beta
+	if alpha <= 0 {
+		alpha = 0.25
+	}
+	if beta <= 0 {
+		beta = 0.25
+	}
+
+	dHat := prev.DHat
+	sigma2 := prev.Sigma2
+	if prev.UpdatedAt.IsZero() {
+		// First observation for this seed/role: seed dHat from it instead
+		// of smoothing in from zero.
+		dHat = float64(last.IncomingOrders)
+	}
+
+	incoming := float64(last.IncomingOrders)
+	dHat = alpha*incoming + (1-alpha)*dHat
+	errTerm := incoming - dHat
+	sigma2 = (1-beta)*sigma2 + beta*errTerm*errTerm
+
+	horizon := float64(cfg.LeadTime + cfg.ReviewPeriod)
+	s := horizon*dHat + cfg.ServiceZ*math.Sqrt(sigma2)*math.Sqrt(horizon)
+
+	inventoryPosition := float64(last.Inventory - last.Backlog + pipeline)
+	order := int(math.Round(s - inventoryPosition))
+	if order < 0 {
+		order = 0
+	}
+
+	return order, RoleLearningState{DHat: dHat, Sigma2: sigma2, LastS: s, UpdatedAt: time.Now()}
+}
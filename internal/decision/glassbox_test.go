@@ -0,0 +1,108 @@
+package decision
+
+import "testing"
+
+// shermanStepHistory builds a classic Sterman-style demand shock: constant
+// demand of 4 units/week for the first few weeks, then a step up to 8.
+func shermanStepHistory(weeks int, stepAt int) []RoleState {
+	history := make([]RoleState, 0, weeks)
+	inventory := 12
+	backlog := 0
+	for w := 0; w < weeks; w++ {
+		incoming := 4
+		if w >= stepAt {
+			incoming = 8
+		}
+		history = append(history, RoleState{
+			Inventory:         inventory,
+			Backlog:           backlog,
+			IncomingOrders:    incoming,
+			ArrivingShipments: 4,
+		})
+	}
+	return history
+}
+
+func TestGlassBoxOrderNonNegative(t *testing.T) {
+	history := shermanStepHistory(12, 4)
+	orders := make([]int, len(history))
+	for i := range history {
+		o := GlassBoxOrder(history[:i+1], orders[:i], DefaultGlassBoxConfig())
+		if o < 0 {
+			t.Fatalf("week %d: order must be non-negative, got %d", i, o)
+		}
+		orders[i] = o
+	}
+}
+
+func TestGlassBoxOrderTracksDemandStep(t *testing.T) {
+	cfg := DefaultGlassBoxConfig()
+	history := shermanStepHistory(12, 4)
+
+	orders := make([]int, len(history))
+	for i := range history {
+		orders[i] = GlassBoxOrder(history[:i+1], orders[:i], cfg)
+	}
+
+	// After the step from 4 to 8, the glass-box order should settle above
+	// the pre-step steady-state order.
+	preStep := orders[3]
+	postStep := orders[len(orders)-1]
+	if postStep <= preStep {
+		t.Fatalf("expected glass-box order to rise after demand step: pre=%d post=%d", preStep, postStep)
+	}
+}
+
+func TestGlassBoxOrderWithStateMatchesGlassBoxOrder(t *testing.T) {
+	cfg := DefaultGlassBoxConfig()
+	history := shermanStepHistory(12, 4)
+	orders := make([]int, len(history))
+	for i := range history[:len(history)-1] {
+		orders[i] = GlassBoxOrder(history[:i+1], orders[:i], cfg)
+	}
+
+	want := GlassBoxOrder(history, orders[:len(orders)-1], cfg)
+	got, state := GlassBoxOrderWithState(history, orders[:len(orders)-1], cfg)
+	if got != want {
+		t.Fatalf("GlassBoxOrderWithState order = %d, want %d (GlassBoxOrder)", got, want)
+	}
+	if state.UpdatedAt.IsZero() {
+		t.Fatalf("expected a non-zero UpdatedAt on the returned state")
+	}
+}
+
+func TestGlassBoxVsBlackBoxBothRespondToShock(t *testing.T) {
+	history := shermanStepHistory(12, 4)
+
+	blackOrders := make([]int, len(history))
+	glassOrders := make([]int, len(history))
+	for i := range history {
+		blackOrders[i] = BlackBoxOrderWithPipeline(history[:i+1], blackOrders[:i], 10, 4)
+		glassOrders[i] = GlassBoxOrder(history[:i+1], glassOrders[:i], DefaultGlassBoxConfig())
+	}
+
+	for i, o := range blackOrders {
+		if o < 0 {
+			t.Fatalf("blackbox week %d: negative order %d", i, o)
+		}
+	}
+	for i, o := range glassOrders {
+		if o < 0 {
+			t.Fatalf("glassbox week %d: negative order %d", i, o)
+		}
+	}
+
+	// Both policies must order more at some point after the step (week 4)
+	// than in the last pre-step week.
+	for name, orders := range map[string][]int{"blackbox": blackOrders, "glassbox": glassOrders} {
+		peak := 0
+		for _, o := range orders[4:] {
+			if o > peak {
+				peak = o
+			}
+		}
+		if peak <= orders[3] {
+			t.Fatalf("%s: expected an order above the pre-step order %d after the shock, peak=%d", name, orders[3], peak)
+		}
+	}
+}
@@ -27,3 +27,14 @@ func ExtractRoleHistory(weeks []WeekState, role string) []RoleState {
 	}
 	return out
 }
+
+// ExtractRoleOrders returns the order a role placed each week, in the same
+// week order as ExtractRoleHistory, for use as the roleOrders argument to
+// BlackBoxOrderWithPipeline/GlassBoxOrder.
+func ExtractRoleOrders(weeks []WeekState, role string) []int {
+	out := make([]int, 0, len(weeks))
+	for _, w := range weeks {
+		out = append(out, w.Orders[role])
+	}
+	return out
+}
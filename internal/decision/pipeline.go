@@ -0,0 +1,20 @@
+package decision
+
+// ReconstructPipeline returns outstanding orders not yet arrived: the
+// running sum of roleOrders minus ArrivingShipments, clamped at 0 each
+// week. Both BlackBoxOrderWithPipeline and GlassBoxOrder use this, and
+// callers that need the pipeline for logging/telemetry (see internal/api)
+// can call it directly instead of re-deriving it.
+func ReconstructPipeline(roleHistory []RoleState, roleOrders []int) int {
+	pipeline := 0
+	for i := 0; i < len(roleHistory); i++ {
+		if i < len(roleOrders) {
+			pipeline += roleOrders[i]
+		}
+		pipeline -= roleHistory[i].ArrivingShipments
+		if pipeline < 0 {
+			pipeline = 0
+		}
+	}
+	return pipeline
+}
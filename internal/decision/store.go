@@ -0,0 +1,31 @@
+package decision
+
+import "time"
+
+// State is the rolling, per-seed learning state the glass-box policy would
+// otherwise have to recompute from scratch on every POST: the
+// exponentially-smoothed demand, variance estimate, and last chosen
+// order-up-to level S, keyed by role.
+type State struct {
+	Roles map[string]RoleLearningState
+}
+
+// RoleLearningState holds the smoothing state for a single role within a
+// game (identified by seed).
+type RoleLearningState struct {
+	DHat      float64 // smoothed demand
+	Sigma2    float64 // smoothed demand variance
+	LastS     float64 // last order-up-to level
+	UpdatedAt time.Time
+}
+
+// Store persists State across requests for the same seed, so a multi-week
+// game doesn't need all history replayed on every call. Implementations
+// must be safe for concurrent use.
+type Store interface {
+	// Get returns the stored State for seed, or ok=false if absent or
+	// expired.
+	Get(seed int) (State, bool)
+	// Put stores State for seed, resetting its TTL.
+	Put(seed int, state State) error
+}
@@ -0,0 +1,66 @@
+package decision
+
+import (
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strconv"
+	"time"
+)
+
+// FileStore is a Store backed by one JSON file per seed on disk
+// (STATE_BACKEND=file). It survives restarts of a single instance, which
+// is enough for local dev and single-replica deployments without needing
+// NATS.
+type FileStore struct {
+	dir string
+	ttl time.Duration
+}
+
+type fileEntry struct {
+	State     State     `json:"state"`
+	UpdatedAt time.Time `json:"updated_at"`
+}
+
+// NewFileStore returns a FileStore rooted at dir, creating it if needed.
+// ttl<=0 disables expiry.
+func NewFileStore(dir string, ttl time.Duration) (*FileStore, error) {
+	if err := os.MkdirAll(dir, 0o755); err != nil {
+		return nil, fmt.Errorf("create state dir: %w", err)
+	}
+	return &FileStore{dir: dir, ttl: ttl}, nil
+}
+
+func (s *FileStore) path(seed int) string {
+	return filepath.Join(s.dir, strconv.Itoa(seed)+".json")
+}
+
+func (s *FileStore) Get(seed int) (State, bool) {
+	data, err := os.ReadFile(s.path(seed))
+	if err != nil {
+		return State{}, false
+	}
+
+	var e fileEntry
+	if err := json.Unmarshal(data, &e); err != nil {
+		return State{}, false
+	}
+	if s.ttl > 0 && time.Now().After(e.UpdatedAt.Add(s.ttl)) {
+		_ = os.Remove(s.path(seed))
+		return State{}, false
+	}
+	return e.State, true
+}
+
+func (s *FileStore) Put(seed int, state State) error {
+	e := fileEntry{State: state, UpdatedAt: time.Now()}
+	data, err := json.Marshal(e)
+	if err != nil {
+		return fmt.Errorf("marshal state: %w", err)
+	}
+	if err := os.WriteFile(s.path(seed), data, 0o644); err != nil {
+		return fmt.Errorf("write state file: %w", err)
+	}
+	return nil
+}
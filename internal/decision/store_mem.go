@@ -0,0 +1,53 @@
+package decision
+
+import (
+	"sync"
+	"time"
+)
+
+// MemStore is an in-process Store backed by a map. It's the default
+// backend (STATE_BACKEND=mem) and is fine for a single instance; it does
+// not survive a restart and isn't shared across replicas.
+type MemStore struct {
+	mu  sync.Mutex
+	ttl time.Duration
+	m   map[int]memEntry
+}
+
+type memEntry struct {
+	state     State
+	expiresAt time.Time
+}
+
+// NewMemStore returns a MemStore that evicts entries ttl after their last
+// Put. ttl<=0 disables expiry.
+func NewMemStore(ttl time.Duration) *MemStore {
+	return &MemStore{ttl: ttl, m: make(map[int]memEntry)}
+}
+
+func (s *MemStore) Get(seed int) (State, bool) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	e, ok := s.m[seed]
+	if !ok {
+		return State{}, false
+	}
+	if s.ttl > 0 && time.Now().After(e.expiresAt) {
+		delete(s.m, seed)
+		return State{}, false
+	}
+	return e.state, true
+}
+
+func (s *MemStore) Put(seed int, state State) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+
+	var expiresAt time.Time
+	if s.ttl > 0 {
+		expiresAt = time.Now().Add(s.ttl)
+	}
+	s.m[seed] = memEntry{state: state, expiresAt: expiresAt}
+	return nil
+}
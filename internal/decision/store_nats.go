@@ -0,0 +1,74 @@
+package decision
+
+import (
+	"encoding/json"
+	"fmt"
+	"strconv"
+	"time"
+
+	"github.com/nats-io/nats.go"
+)
+
+// natsStateBucket is the JetStream KV bucket used for per-seed learning
+// state, following the bucket-per-concern pattern used by the autocoffee
+// example (bucket "beerbot-state", key = seed).
+const natsStateBucket = "beerbot-state"
+
+// NATSStore is a Store backed by a NATS JetStream key-value bucket
+// (STATE_BACKEND=nats), so learning state is shared across replicas
+// instead of pinned to whichever instance handled the last week.
+type NATSStore struct {
+	kv nats.KeyValue
+}
+
+// NewNATSStore connects to natsURL and opens the beerbot-state bucket,
+// creating it with the given TTL (0 disables expiry) if it does not exist.
+// An existing bucket keeps the TTL it was created with.
+func NewNATSStore(natsURL string, ttl time.Duration) (*NATSStore, error) {
+	nc, err := nats.Connect(natsURL)
+	if err != nil {
+		return nil, fmt.Errorf("connect to nats: %w", err)
+	}
+
+	js, err := nc.JetStream()
+	if err != nil {
+		return nil, fmt.Errorf("jetstream context: %w", err)
+	}
+
+	kv, err := js.KeyValue(natsStateBucket)
+	if err != nil {
+		kv, err = js.CreateKeyValue(&nats.KeyValueConfig{
+			Bucket: natsStateBucket,
+			TTL:    ttl,
+		})
+		if err != nil {
+			return nil, fmt.Errorf("create kv bucket %s: %w", natsStateBucket, err)
+		}
+	}
+
+	return &NATSStore{kv: kv}, nil
+}
+
+func (s *NATSStore) Get(seed int) (State, bool) {
+	entry, err := s.kv.Get(strconv.Itoa(seed))
+	if err != nil {
+		return State{}, false
+	}
+
+	var state State
+	if err := json.Unmarshal(entry.Value(), &state); err != nil {
+		return State{}, false
+	}
+	return state, true
+}
+
+func (s *NATSStore) Put(seed int, state State) error {
+	data, err := json.Marshal(state)
+	if err != nil {
+		return fmt.Errorf("marshal state: %w", err)
+	}
+	if _, err := s.kv.Put(strconv.Itoa(seed), data); err != nil {
+		return fmt.Errorf("put kv entry: %w", err)
+	}
+	return nil
+}
@@ -0,0 +1,62 @@
+package decision
+
+import (
+	"path/filepath"
+	"testing"
+	"time"
+)
+
+func TestMemStoreGetPutRoundTrip(t *testing.T) {
+	s := NewMemStore(0)
+
+	if _, ok := s.Get(42); ok {
+		t.Fatalf("expected no state for unseen seed")
+	}
+
+	want := State{Roles: map[string]RoleLearningState{"retailer": {DHat: 5, Sigma2: 1}}}
+	if err := s.Put(42, want); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	got, ok := s.Get(42)
+	if !ok {
+		t.Fatalf("expected state after Put")
+	}
+	if got.Roles["retailer"].DHat != 5 {
+		t.Fatalf("expected DHat=5, got %v", got.Roles["retailer"])
+	}
+}
+
+func TestMemStoreTTLExpiry(t *testing.T) {
+	s := NewMemStore(10 * time.Millisecond)
+	if err := s.Put(1, State{}); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	time.Sleep(20 * time.Millisecond)
+
+	if _, ok := s.Get(1); ok {
+		t.Fatalf("expected seed 1 to be evicted after TTL")
+	}
+}
+
+func TestFileStoreGetPutRoundTrip(t *testing.T) {
+	dir := filepath.Join(t.TempDir(), "state")
+	s, err := NewFileStore(dir, 0)
+	if err != nil {
+		t.Fatalf("NewFileStore: %v", err)
+	}
+
+	want := State{Roles: map[string]RoleLearningState{"factory": {DHat: 8, Sigma2: 2, LastS: 20}}}
+	if err := s.Put(7, want); err != nil {
+		t.Fatalf("Put: %v", err)
+	}
+
+	got, ok := s.Get(7)
+	if !ok {
+		t.Fatalf("expected state after Put")
+	}
+	if got.Roles["factory"].LastS != 20 {
+		t.Fatalf("expected LastS=20, got %v", got.Roles["factory"])
+	}
+}
@@ -0,0 +1,92 @@
+// Package grpcapi implements the Decision gRPC service declared in
+// proto/decision/v1/decision.proto. It backs both the raw gRPC port and,
+// via grpc-gateway, the JSON HTTP contract — so internal/api and this
+// package share the same decision logic instead of drifting apart.
+package grpcapi
+
+import (
+	"context"
+	"log"
+
+	"beerbot-go/internal/api"
+	"beerbot-go/internal/decision"
+	pb "beerbot-go/internal/pb/decisionv1"
+)
+
+// Server implements pb.DecisionServer on top of api.Config and the
+// internal/decision policies.
+type Server struct {
+	pb.UnimplementedDecisionServer
+	cfg   api.Config
+	store decision.Store
+}
+
+// NewServer returns a Decision gRPC server backed by cfg, with glassbox
+// learning state disabled (equivalent to api.NewDecisionHandlerBuffered).
+func NewServer(cfg api.Config) *Server {
+	return &Server{cfg: cfg}
+}
+
+// NewServerWithStore is NewServer with a decision.Store plugged in, so
+// glassbox mode resumes per-seed learning state the same way
+// api.NewDecisionHandlerBufferedWithStore does.
+func NewServerWithStore(cfg api.Config, store decision.Store) *Server {
+	return &Server{cfg: cfg, store: store}
+}
+
+func (s *Server) Handshake(ctx context.Context, req *pb.HandshakeRequest) (*pb.HandshakeResponse, error) {
+	log.Printf("[GRPC HANDSHAKE] ping=%q seed=%d", req.GetPing(), req.GetSeed())
+	return &pb.HandshakeResponse{
+		Ok:            true,
+		StudentEmail:  s.cfg.StudentEmail,
+		AlgorithmName: s.cfg.AlgorithmName,
+		Version:       s.cfg.Version,
+		Supports: map[string]bool{
+			"blackbox": s.cfg.SupportsBlackBox,
+			"glassbox": s.cfg.SupportsGlassBox,
+		},
+		Message: "BeerBot ready",
+	}, nil
+}
+
+func (s *Server) Weekly(ctx context.Context, req *pb.WeeklyRequest) (*pb.WeeklyResponse, error) {
+	weeks := toWeekStates(req.GetWeeks())
+	log.Printf("[GRPC WEEK] mode=%s week=%d/%d seed=%d weeks_len=%d",
+		req.GetMode(), req.GetWeek(), req.GetWeeksTotal(), req.GetSeed(), len(weeks))
+
+	// Same order-selection logic as /api/decision: mode/store-aware policy
+	// choice, tracing spans and Prometheus metrics all live in
+	// api.ComputeRoleOrders so this transport can't drift from the HTTP one.
+	result := api.ComputeRoleOrders(ctx, s.cfg, s.store, req.GetMode(), int(req.GetSeed()), weeks)
+	orders := make(map[string]int64, len(result))
+	for role, o := range result {
+		orders[role] = int64(o)
+	}
+
+	return &pb.WeeklyResponse{Orders: orders}, nil
+}
+
+func toWeekStates(weeks []*pb.WeekState) []decision.WeekState {
+	out := make([]decision.WeekState, 0, len(weeks))
+	for _, w := range weeks {
+		roles := make(map[string]decision.RoleState, len(w.GetRoles()))
+		for role, rs := range w.GetRoles() {
+			roles[role] = decision.RoleState{
+				Inventory:         int(rs.GetInventory()),
+				Backlog:           int(rs.GetBacklog()),
+				IncomingOrders:    int(rs.GetIncomingOrders()),
+				ArrivingShipments: int(rs.GetArrivingShipments()),
+			}
+		}
+		ordersByRole := make(map[string]int, len(w.GetOrders()))
+		for role, o := range w.GetOrders() {
+			ordersByRole[role] = int(o)
+		}
+		out = append(out, decision.WeekState{
+			Week:   int(w.GetWeek()),
+			Roles:  roles,
+			Orders: ordersByRole,
+		})
+	}
+	return out
+}
@@ -0,0 +1,91 @@
+package grpcapi
+
+import (
+	"context"
+	"net"
+	"testing"
+
+	"google.golang.org/grpc"
+	"google.golang.org/grpc/credentials/insecure"
+	"google.golang.org/grpc/test/bufconn"
+
+	"beerbot-go/internal/api"
+	pb "beerbot-go/internal/pb/decisionv1"
+)
+
+// dialBufServer starts Server on an in-memory listener and returns a
+// connected generated client, so we can exercise the decision layer the
+// same way a real gRPC caller would instead of round-tripping JSON.
+func dialBufServer(t *testing.T, cfg api.Config) pb.DecisionClient {
+	t.Helper()
+
+	lis := bufconn.Listen(1024 * 1024)
+	s := grpc.NewServer()
+	pb.RegisterDecisionServer(s, NewServer(cfg))
+	go func() {
+		_ = s.Serve(lis)
+	}()
+	t.Cleanup(s.Stop)
+
+	conn, err := grpc.NewClient("passthrough:///bufnet",
+		grpc.WithContextDialer(func(ctx context.Context, _ string) (net.Conn, error) {
+			return lis.DialContext(ctx)
+		}),
+		grpc.WithTransportCredentials(insecure.NewCredentials()),
+	)
+	if err != nil {
+		t.Fatalf("dial bufconn: %v", err)
+	}
+	t.Cleanup(func() { _ = conn.Close() })
+
+	return pb.NewDecisionClient(conn)
+}
+
+func testConfig() api.Config {
+	return api.Config{
+		StudentEmail:     "first.last@taltech.ee",
+		AlgorithmName:    "BeerBot_Test",
+		Version:          "v1.0.0",
+		SupportsBlackBox: true,
+		SafetyStock:      10,
+		MAWindow:         4,
+	}
+}
+
+func TestHandshake(t *testing.T) {
+	client := dialBufServer(t, testConfig())
+
+	resp, err := client.Handshake(context.Background(), &pb.HandshakeRequest{Handshake: true, Ping: "hi", Seed: 42})
+	if err != nil {
+		t.Fatalf("Handshake: %v", err)
+	}
+	if !resp.GetOk() {
+		t.Fatalf("expected ok=true")
+	}
+	if resp.GetMessage() != "BeerBot ready" {
+		t.Fatalf("expected message %q, got %q", "BeerBot ready", resp.GetMessage())
+	}
+	if !resp.GetSupports()["blackbox"] {
+		t.Fatalf("expected blackbox support advertised")
+	}
+}
+
+func TestWeeklyEmptyHistoryDefaultsToTen(t *testing.T) {
+	client := dialBufServer(t, testConfig())
+
+	resp, err := client.Weekly(context.Background(), &pb.WeeklyRequest{
+		Mode:       "blackbox",
+		Week:       1,
+		WeeksTotal: 36,
+		Seed:       1,
+		Weeks:      []*pb.WeekState{},
+	})
+	if err != nil {
+		t.Fatalf("Weekly: %v", err)
+	}
+	for _, role := range []string{"retailer", "wholesaler", "distributor", "factory"} {
+		if got := resp.GetOrders()[role]; got != 10 {
+			t.Errorf("role %s: expected default order 10, got %d", role, got)
+		}
+	}
+}
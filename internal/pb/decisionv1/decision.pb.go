@@ -0,0 +1,232 @@
+// Hand-maintained shim for proto/decision/v1/decision.proto — there is no
+// protoc/buf toolchain checked into this repo, so this is NOT actually
+// produced by protoc-gen-go. It works at runtime via protobuf's legacy
+// protoadapt.MessageV1 reflection path, which honors the `protobuf:"..."`
+// struct tags below the same way generated code would.
+//
+// If decision.proto changes, update these types by hand to match — there
+// is currently no regeneration command for this package.
+
+package decisionv1
+
+import (
+	fmt "fmt"
+)
+
+type HandshakeRequest struct {
+	Handshake bool   `protobuf:"varint,1,opt,name=handshake,proto3" json:"handshake,omitempty"`
+	Ping      string `protobuf:"bytes,2,opt,name=ping,proto3" json:"ping,omitempty"`
+	Seed      int64  `protobuf:"varint,3,opt,name=seed,proto3" json:"seed,omitempty"`
+}
+
+func (m *HandshakeRequest) Reset()         { *m = HandshakeRequest{} }
+func (m *HandshakeRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*HandshakeRequest) ProtoMessage()    {}
+
+func (m *HandshakeRequest) GetHandshake() bool {
+	if m != nil {
+		return m.Handshake
+	}
+	return false
+}
+
+func (m *HandshakeRequest) GetPing() string {
+	if m != nil {
+		return m.Ping
+	}
+	return ""
+}
+
+func (m *HandshakeRequest) GetSeed() int64 {
+	if m != nil {
+		return m.Seed
+	}
+	return 0
+}
+
+type HandshakeResponse struct {
+	Ok            bool            `protobuf:"varint,1,opt,name=ok,proto3" json:"ok,omitempty"`
+	StudentEmail  string          `protobuf:"bytes,2,opt,name=student_email,json=studentEmail,proto3" json:"student_email,omitempty"`
+	AlgorithmName string          `protobuf:"bytes,3,opt,name=algorithm_name,json=algorithmName,proto3" json:"algorithm_name,omitempty"`
+	Version       string          `protobuf:"bytes,4,opt,name=version,proto3" json:"version,omitempty"`
+	Supports      map[string]bool `protobuf:"bytes,5,rep,name=supports,proto3" json:"supports,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+	Message       string          `protobuf:"bytes,6,opt,name=message,proto3" json:"message,omitempty"`
+}
+
+func (m *HandshakeResponse) Reset()         { *m = HandshakeResponse{} }
+func (m *HandshakeResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*HandshakeResponse) ProtoMessage()    {}
+
+func (m *HandshakeResponse) GetOk() bool {
+	if m != nil {
+		return m.Ok
+	}
+	return false
+}
+
+func (m *HandshakeResponse) GetStudentEmail() string {
+	if m != nil {
+		return m.StudentEmail
+	}
+	return ""
+}
+
+func (m *HandshakeResponse) GetAlgorithmName() string {
+	if m != nil {
+		return m.AlgorithmName
+	}
+	return ""
+}
+
+func (m *HandshakeResponse) GetVersion() string {
+	if m != nil {
+		return m.Version
+	}
+	return ""
+}
+
+func (m *HandshakeResponse) GetSupports() map[string]bool {
+	if m != nil {
+		return m.Supports
+	}
+	return nil
+}
+
+func (m *HandshakeResponse) GetMessage() string {
+	if m != nil {
+		return m.Message
+	}
+	return ""
+}
+
+type RoleState struct {
+	Inventory         int64 `protobuf:"varint,1,opt,name=inventory,proto3" json:"inventory,omitempty"`
+	Backlog           int64 `protobuf:"varint,2,opt,name=backlog,proto3" json:"backlog,omitempty"`
+	IncomingOrders    int64 `protobuf:"varint,3,opt,name=incoming_orders,json=incomingOrders,proto3" json:"incoming_orders,omitempty"`
+	ArrivingShipments int64 `protobuf:"varint,4,opt,name=arriving_shipments,json=arrivingShipments,proto3" json:"arriving_shipments,omitempty"`
+}
+
+func (m *RoleState) Reset()         { *m = RoleState{} }
+func (m *RoleState) String() string { return fmt.Sprintf("%+v", *m) }
+func (*RoleState) ProtoMessage()    {}
+
+func (m *RoleState) GetInventory() int64 {
+	if m != nil {
+		return m.Inventory
+	}
+	return 0
+}
+
+func (m *RoleState) GetBacklog() int64 {
+	if m != nil {
+		return m.Backlog
+	}
+	return 0
+}
+
+func (m *RoleState) GetIncomingOrders() int64 {
+	if m != nil {
+		return m.IncomingOrders
+	}
+	return 0
+}
+
+func (m *RoleState) GetArrivingShipments() int64 {
+	if m != nil {
+		return m.ArrivingShipments
+	}
+	return 0
+}
+
+type WeekState struct {
+	Week   int64                 `protobuf:"varint,1,opt,name=week,proto3" json:"week,omitempty"`
+	Roles  map[string]*RoleState `protobuf:"bytes,2,rep,name=roles,proto3" json:"roles,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"bytes,2,opt,name=value,proto3"`
+	Orders map[string]int64      `protobuf:"bytes,3,rep,name=orders,proto3" json:"orders,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *WeekState) Reset()         { *m = WeekState{} }
+func (m *WeekState) String() string { return fmt.Sprintf("%+v", *m) }
+func (*WeekState) ProtoMessage()    {}
+
+func (m *WeekState) GetWeek() int64 {
+	if m != nil {
+		return m.Week
+	}
+	return 0
+}
+
+func (m *WeekState) GetRoles() map[string]*RoleState {
+	if m != nil {
+		return m.Roles
+	}
+	return nil
+}
+
+func (m *WeekState) GetOrders() map[string]int64 {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
+
+type WeeklyRequest struct {
+	Mode       string       `protobuf:"bytes,1,opt,name=mode,proto3" json:"mode,omitempty"`
+	Week       int64        `protobuf:"varint,2,opt,name=week,proto3" json:"week,omitempty"`
+	WeeksTotal int64        `protobuf:"varint,3,opt,name=weeks_total,json=weeksTotal,proto3" json:"weeks_total,omitempty"`
+	Seed       int64        `protobuf:"varint,4,opt,name=seed,proto3" json:"seed,omitempty"`
+	Weeks      []*WeekState `protobuf:"bytes,5,rep,name=weeks,proto3" json:"weeks,omitempty"`
+}
+
+func (m *WeeklyRequest) Reset()         { *m = WeeklyRequest{} }
+func (m *WeeklyRequest) String() string { return fmt.Sprintf("%+v", *m) }
+func (*WeeklyRequest) ProtoMessage()    {}
+
+func (m *WeeklyRequest) GetMode() string {
+	if m != nil {
+		return m.Mode
+	}
+	return ""
+}
+
+func (m *WeeklyRequest) GetWeek() int64 {
+	if m != nil {
+		return m.Week
+	}
+	return 0
+}
+
+func (m *WeeklyRequest) GetWeeksTotal() int64 {
+	if m != nil {
+		return m.WeeksTotal
+	}
+	return 0
+}
+
+func (m *WeeklyRequest) GetSeed() int64 {
+	if m != nil {
+		return m.Seed
+	}
+	return 0
+}
+
+func (m *WeeklyRequest) GetWeeks() []*WeekState {
+	if m != nil {
+		return m.Weeks
+	}
+	return nil
+}
+
+type WeeklyResponse struct {
+	Orders map[string]int64 `protobuf:"bytes,1,rep,name=orders,proto3" json:"orders,omitempty" protobuf_key:"bytes,1,opt,name=key,proto3" protobuf_val:"varint,2,opt,name=value,proto3"`
+}
+
+func (m *WeeklyResponse) Reset()         { *m = WeeklyResponse{} }
+func (m *WeeklyResponse) String() string { return fmt.Sprintf("%+v", *m) }
+func (*WeeklyResponse) ProtoMessage()    {}
+
+func (m *WeeklyResponse) GetOrders() map[string]int64 {
+	if m != nil {
+		return m.Orders
+	}
+	return nil
+}
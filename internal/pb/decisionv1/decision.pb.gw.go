@@ -0,0 +1,105 @@
+// Hand-maintained shim for proto/decision/v1/decision.proto's HTTP
+// annotations — NOT actually produced by protoc-gen-grpc-gateway (no
+// protoc/buf toolchain is checked into this repo). See decision.pb.go for
+// why the legacy reflection path still makes the wire format work.
+//
+// If decision.proto's google.api.http annotations change, update the
+// routes registered below by hand to match.
+
+package decisionv1
+
+import (
+	"context"
+	"encoding/json"
+	"io"
+	"net/http"
+
+	"github.com/grpc-ecosystem/grpc-gateway/v2/runtime"
+	"google.golang.org/grpc"
+)
+
+// RegisterDecisionHandlerClient registers the http handlers for the Decision
+// service backed by the given gRPC client conn, mirroring the JSON envelopes
+// served by internal/api so the HTTP contract stays byte-for-byte compatible.
+//
+// Like internal/api's handler, these always respond JSON with HTTP 200: a
+// decode failure or gRPC error degrades to a default response instead of
+// runtime.HTTPError's non-200 status, so the contract doesn't change
+// depending on which of the two endpoints a caller hits.
+func RegisterDecisionHandlerClient(mux *runtime.ServeMux, client DecisionClient) error {
+	if err := mux.HandlePath(http.MethodPost, "/v1/decision/handshake", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		var req HandshakeRequest
+		if err := decodeJSONBody(r, &req); err != nil {
+			writeJSON(w, defaultHandshakeResponse())
+			return
+		}
+		resp, err := client.Handshake(r.Context(), &req)
+		if err != nil {
+			writeJSON(w, defaultHandshakeResponse())
+			return
+		}
+		writeJSON(w, resp)
+	}); err != nil {
+		return err
+	}
+
+	return mux.HandlePath(http.MethodPost, "/v1/decision/weekly", func(w http.ResponseWriter, r *http.Request, pathParams map[string]string) {
+		var req WeeklyRequest
+		if err := decodeJSONBody(r, &req); err != nil {
+			writeJSON(w, defaultWeeklyResponse())
+			return
+		}
+		resp, err := client.Weekly(r.Context(), &req)
+		if err != nil {
+			writeJSON(w, defaultWeeklyResponse())
+			return
+		}
+		writeJSON(w, resp)
+	})
+}
+
+// defaultWeeklyResponse mirrors internal/api's defaultOrders(): a safe,
+// non-negative order for every role when the request couldn't be decoded
+// or the backing gRPC call failed.
+func defaultWeeklyResponse() *WeeklyResponse {
+	return &WeeklyResponse{Orders: map[string]int64{
+		"retailer":    10,
+		"wholesaler":  10,
+		"distributor": 10,
+		"factory":     10,
+	}}
+}
+
+// defaultHandshakeResponse is returned when a handshake request couldn't be
+// decoded or the backing gRPC call failed: still HTTP 200 JSON, with Ok
+// false so the caller can tell the handshake didn't actually succeed.
+func defaultHandshakeResponse() *HandshakeResponse {
+	return &HandshakeResponse{
+		Ok:      false,
+		Message: "BeerBot unavailable",
+	}
+}
+
+// RegisterDecisionHandlerFromEndpoint dials target and registers the gateway
+// handlers against the resulting connection.
+func RegisterDecisionHandlerFromEndpoint(ctx context.Context, mux *runtime.ServeMux, target string, opts []grpc.DialOption) error {
+	conn, err := grpc.DialContext(ctx, target, opts...)
+	if err != nil {
+		return err
+	}
+	return RegisterDecisionHandlerClient(mux, NewDecisionClient(conn))
+}
+
+func decodeJSONBody(r *http.Request, v interface{}) error {
+	defer r.Body.Close()
+	body, err := io.ReadAll(http.MaxBytesReader(nil, r.Body, 1<<20))
+	if err != nil {
+		return err
+	}
+	return json.Unmarshal(body, v)
+}
+
+func writeJSON(w http.ResponseWriter, v interface{}) {
+	w.Header().Set("Content-Type", "application/json")
+	_ = json.NewEncoder(w).Encode(v)
+}
@@ -0,0 +1,121 @@
+// Hand-maintained shim for proto/decision/v1/decision.proto — NOT actually
+// produced by protoc-gen-go-grpc (no protoc/buf toolchain is checked into
+// this repo). See decision.pb.go for why the legacy reflection path still
+// makes this work at runtime.
+//
+// If decision.proto's service definition changes, update this client/server
+// plumbing by hand to match.
+
+package decisionv1
+
+import (
+	context "context"
+
+	grpc "google.golang.org/grpc"
+)
+
+// DecisionClient is the client API for the Decision service.
+type DecisionClient interface {
+	Handshake(ctx context.Context, in *HandshakeRequest, opts ...grpc.CallOption) (*HandshakeResponse, error)
+	Weekly(ctx context.Context, in *WeeklyRequest, opts ...grpc.CallOption) (*WeeklyResponse, error)
+}
+
+type decisionClient struct {
+	cc grpc.ClientConnInterface
+}
+
+func NewDecisionClient(cc grpc.ClientConnInterface) DecisionClient {
+	return &decisionClient{cc}
+}
+
+func (c *decisionClient) Handshake(ctx context.Context, in *HandshakeRequest, opts ...grpc.CallOption) (*HandshakeResponse, error) {
+	out := new(HandshakeResponse)
+	err := c.cc.Invoke(ctx, "/beerbot.v1.Decision/Handshake", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+func (c *decisionClient) Weekly(ctx context.Context, in *WeeklyRequest, opts ...grpc.CallOption) (*WeeklyResponse, error) {
+	out := new(WeeklyResponse)
+	err := c.cc.Invoke(ctx, "/beerbot.v1.Decision/Weekly", in, out, opts...)
+	if err != nil {
+		return nil, err
+	}
+	return out, nil
+}
+
+// DecisionServer is the server API for the Decision service.
+type DecisionServer interface {
+	Handshake(context.Context, *HandshakeRequest) (*HandshakeResponse, error)
+	Weekly(context.Context, *WeeklyRequest) (*WeeklyResponse, error)
+}
+
+// UnimplementedDecisionServer can be embedded for forward compatibility.
+type UnimplementedDecisionServer struct{}
+
+func (UnimplementedDecisionServer) Handshake(context.Context, *HandshakeRequest) (*HandshakeResponse, error) {
+	return nil, grpcNotImplemented("Handshake")
+}
+
+func (UnimplementedDecisionServer) Weekly(context.Context, *WeeklyRequest) (*WeeklyResponse, error) {
+	return nil, grpcNotImplemented("Weekly")
+}
+
+func RegisterDecisionServer(s *grpc.Server, srv DecisionServer) {
+	s.RegisterService(&Decision_ServiceDesc, srv)
+}
+
+func grpcNotImplemented(method string) error {
+	return &notImplementedError{method: method}
+}
+
+type notImplementedError struct{ method string }
+
+func (e *notImplementedError) Error() string {
+	return "method " + e.method + " not implemented"
+}
+
+var Decision_ServiceDesc = grpc.ServiceDesc{
+	ServiceName: "beerbot.v1.Decision",
+	HandlerType: (*DecisionServer)(nil),
+	Methods: []grpc.MethodDesc{
+		{
+			MethodName: "Handshake",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(HandshakeRequest)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				if interceptor == nil {
+					return srv.(DecisionServer).Handshake(ctx, in)
+				}
+				info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/beerbot.v1.Decision/Handshake"}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(DecisionServer).Handshake(ctx, req.(*HandshakeRequest))
+				}
+				return interceptor(ctx, in, info, handler)
+			},
+		},
+		{
+			MethodName: "Weekly",
+			Handler: func(srv interface{}, ctx context.Context, dec func(interface{}) error, interceptor grpc.UnaryServerInterceptor) (interface{}, error) {
+				in := new(WeeklyRequest)
+				if err := dec(in); err != nil {
+					return nil, err
+				}
+				if interceptor == nil {
+					return srv.(DecisionServer).Weekly(ctx, in)
+				}
+				info := &grpc.UnaryServerInfo{Server: srv, FullMethod: "/beerbot.v1.Decision/Weekly"}
+				handler := func(ctx context.Context, req interface{}) (interface{}, error) {
+					return srv.(DecisionServer).Weekly(ctx, req.(*WeeklyRequest))
+				}
+				return interceptor(ctx, in, info, handler)
+			},
+		},
+	},
+	Streams:  []grpc.StreamDesc{},
+	Metadata: "proto/decision/v1/decision.proto",
+}
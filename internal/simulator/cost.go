@@ -0,0 +1,70 @@
+package simulator
+
+// CostWeights are the per-unit-per-week costs used by RoleCost.
+type CostWeights struct {
+	Holding float64 // cost per unit of on-hand inventory per week
+	Backlog float64 // cost per unit of backlog per week
+}
+
+// DefaultCostWeights mirrors the classic Beer Game costing: backlog costs
+// twice as much as holding inventory.
+func DefaultCostWeights() CostWeights {
+	return CostWeights{Holding: 0.5, Backlog: 1.0}
+}
+
+// RoleCost returns total holding+backlog cost for a single role's history.
+func RoleCost(r RoleResult, weights CostWeights) float64 {
+	total := 0.0
+	for _, s := range r.History {
+		total += float64(s.Inventory)*weights.Holding + float64(s.Backlog)*weights.Backlog
+	}
+	return total
+}
+
+// TotalCost sums RoleCost across every role in result.
+func TotalCost(result Result, weights CostWeights) float64 {
+	total := 0.0
+	for _, role := range roles {
+		total += RoleCost(result.Roles[role], weights)
+	}
+	return total
+}
+
+// BullwhipRatio is variance(factory orders) / variance(customer demand):
+// how much order variability amplifies as it travels upstream. A ratio of
+// 1 means no amplification; Beer Game runs typically show several-fold
+// amplification at the factory.
+func BullwhipRatio(result Result) float64 {
+	customerVar := variance(toFloat(result.CustomerDemand))
+	if customerVar == 0 {
+		return 0
+	}
+	factoryVar := variance(toFloat(result.Roles["factory"].Orders))
+	return factoryVar / customerVar
+}
+
+func toFloat(xs []int) []float64 {
+	out := make([]float64, len(xs))
+	for i, x := range xs {
+		out[i] = float64(x)
+	}
+	return out
+}
+
+func variance(xs []float64) float64 {
+	if len(xs) == 0 {
+		return 0
+	}
+	mean := 0.0
+	for _, x := range xs {
+		mean += x
+	}
+	mean /= float64(len(xs))
+
+	sum := 0.0
+	for _, x := range xs {
+		d := x - mean
+		sum += d * d
+	}
+	return sum / float64(len(xs))
+}
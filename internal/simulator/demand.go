@@ -0,0 +1,51 @@
+// Package simulator runs a full, local Beer Game so SafetyStock, MAWindow,
+// and the glass-box parameters can be tuned against reproducible demand
+// patterns instead of guessed. It calls the same internal/decision
+// policies the live handler uses.
+package simulator
+
+import "math"
+
+// DemandKind selects a customer demand generator.
+type DemandKind string
+
+const (
+	DemandConstant DemandKind = "constant"
+	DemandStep     DemandKind = "step"
+	DemandSinusoid DemandKind = "sinusoid"
+	// DemandSterman is the classic Sterman Beer Game shock: constant
+	// demand of 4/week, stepping up to 8/week at week 5 and staying
+	// there — the scenario the bullwhip effect is usually demonstrated on.
+	DemandSterman DemandKind = "sterman"
+)
+
+// DemandGenerator returns customer demand for a given 1-indexed week.
+type DemandGenerator func(week int) int
+
+// NewDemandGenerator builds the generator for kind. Week is 1-indexed to
+// match WeekState.Week elsewhere in the decision package.
+func NewDemandGenerator(kind DemandKind) DemandGenerator {
+	switch kind {
+	case DemandStep:
+		return func(week int) int {
+			if week < 10 {
+				return 4
+			}
+			return 12
+		}
+	case DemandSinusoid:
+		return func(week int) int {
+			v := 8 + 4*math.Sin(float64(week)/3)
+			return int(math.Round(v))
+		}
+	case DemandSterman:
+		return func(week int) int {
+			if week < 5 {
+				return 4
+			}
+			return 8
+		}
+	default: // DemandConstant
+		return func(week int) int { return 4 }
+	}
+}
@@ -0,0 +1,135 @@
+package simulator
+
+import "beerbot-go/internal/decision"
+
+// roles lists the 4-echelon chain in downstream-to-upstream order, matching
+// internal/api's role set.
+var roles = []string{"retailer", "wholesaler", "distributor", "factory"}
+
+// Policy decides the order quantity for one role given its history so far.
+// decision.BlackBoxOrderWithPipeline and decision.GlassBoxOrder (adapted,
+// see BlackBoxPolicy/GlassBoxPolicy below) both satisfy this signature.
+type Policy func(history []decision.RoleState, orderHistory []int) int
+
+// BlackBoxPolicy adapts decision.BlackBoxOrderWithPipeline to Policy.
+func BlackBoxPolicy(safetyStock, window int) Policy {
+	return func(history []decision.RoleState, orderHistory []int) int {
+		return decision.BlackBoxOrderWithPipeline(history, orderHistory, safetyStock, window)
+	}
+}
+
+// GlassBoxPolicy adapts decision.GlassBoxOrder to Policy.
+func GlassBoxPolicy(cfg decision.GlassBoxConfig) Policy {
+	return func(history []decision.RoleState, orderHistory []int) int {
+		return decision.GlassBoxOrder(history, orderHistory, cfg)
+	}
+}
+
+// Config parameterizes a single simulation run.
+type Config struct {
+	Weeks            int // typically 36
+	LeadTime         int // shipment lead time per echelon, default 2
+	InitialInventory int // default 12
+	InitialPipeline  int // orders assumed in flight before week 1, default 4/week
+	Demand           DemandGenerator
+	Policy           Policy
+}
+
+// RoleResult is one role's full week-by-week trace plus its chosen orders.
+type RoleResult struct {
+	Role    string
+	History []decision.RoleState
+	Orders  []int
+}
+
+// Result is the outcome of a full Run: every role's trace and the raw
+// customer demand series (needed for the bullwhip ratio).
+type Result struct {
+	Roles          map[string]RoleResult
+	CustomerDemand []int
+}
+
+// Run simulates cfg.Weeks of the 4-echelon Beer Game. Each role orders
+// from an "infinite capacity" upstream: whatever a role orders arrives as
+// ArrivingShipments exactly cfg.LeadTime weeks later, regardless of
+// whether the upstream role could really supply it. That keeps the model
+// simple enough to grid-search while still reproducing the bullwhip
+// effect driven by each role's own ordering policy.
+func Run(cfg Config) Result {
+	leadTime := cfg.LeadTime
+	if leadTime <= 0 {
+		leadTime = 2
+	}
+	initialInventory := cfg.InitialInventory
+	if initialInventory == 0 {
+		initialInventory = 12
+	}
+	initialPipeline := cfg.InitialPipeline
+	if initialPipeline == 0 {
+		initialPipeline = 4
+	}
+
+	inventory := make(map[string]int, len(roles))
+	backlog := make(map[string]int, len(roles))
+	history := make(map[string][]decision.RoleState, len(roles))
+	orderHistory := make(map[string][]int, len(roles))
+	for _, role := range roles {
+		inventory[role] = initialInventory
+	}
+
+	customerDemand := make([]int, cfg.Weeks)
+
+	for week := 1; week <= cfg.Weeks; week++ {
+		for i, role := range roles {
+			var incoming int
+			if i == 0 {
+				incoming = cfg.Demand(week)
+				customerDemand[week-1] = incoming
+			} else {
+				downstream := roles[i-1]
+				// Orders take one week to transmit upstream.
+				if idx := week - 2; idx >= 0 && idx < len(orderHistory[downstream]) {
+					incoming = orderHistory[downstream][idx]
+				} else {
+					incoming = initialPipeline
+				}
+			}
+
+			var arriving int
+			if idx := week - leadTime - 1; idx >= 0 && idx < len(orderHistory[role]) {
+				arriving = orderHistory[role][idx]
+			} else {
+				arriving = initialPipeline
+			}
+
+			inventory[role] += arriving
+			totalDemand := incoming + backlog[role]
+			shipped := totalDemand
+			if shipped > inventory[role] {
+				shipped = inventory[role]
+			}
+			inventory[role] -= shipped
+			backlog[role] = totalDemand - shipped
+
+			state := decision.RoleState{
+				Inventory:         inventory[role],
+				Backlog:           backlog[role],
+				IncomingOrders:    incoming,
+				ArrivingShipments: arriving,
+			}
+			history[role] = append(history[role], state)
+
+			order := cfg.Policy(history[role], orderHistory[role])
+			if order < 0 {
+				order = 0
+			}
+			orderHistory[role] = append(orderHistory[role], order)
+		}
+	}
+
+	out := Result{Roles: make(map[string]RoleResult, len(roles)), CustomerDemand: customerDemand}
+	for _, role := range roles {
+		out.Roles[role] = RoleResult{Role: role, History: history[role], Orders: orderHistory[role]}
+	}
+	return out
+}
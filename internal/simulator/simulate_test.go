@@ -0,0 +1,64 @@
+package simulator
+
+import (
+	"testing"
+
+	"beerbot-go/internal/decision"
+)
+
+func runSterman(t *testing.T, policy Policy) Result {
+	t.Helper()
+	return Run(Config{
+		Weeks:    36,
+		LeadTime: 2,
+		Demand:   NewDemandGenerator(DemandSterman),
+		Policy:   policy,
+	})
+}
+
+func TestRunProducesFullHistoryPerRole(t *testing.T) {
+	result := runSterman(t, BlackBoxPolicy(10, 4))
+
+	for _, role := range roles {
+		rr, ok := result.Roles[role]
+		if !ok {
+			t.Fatalf("missing role %s", role)
+		}
+		if len(rr.History) != 36 {
+			t.Errorf("role %s: expected 36 weeks of history, got %d", role, len(rr.History))
+		}
+		if len(rr.Orders) != 36 {
+			t.Errorf("role %s: expected 36 orders, got %d", role, len(rr.Orders))
+		}
+	}
+	if len(result.CustomerDemand) != 36 {
+		t.Errorf("expected 36 weeks of customer demand, got %d", len(result.CustomerDemand))
+	}
+}
+
+func TestBullwhipRatioAmplifiesUpstream(t *testing.T) {
+	result := runSterman(t, BlackBoxPolicy(10, 4))
+
+	ratio := BullwhipRatio(result)
+	if ratio <= 1 {
+		t.Errorf("expected factory order variance to amplify customer demand variance on a Sterman shock, got ratio=%.3f", ratio)
+	}
+}
+
+func TestGlassBoxVsBlackBoxCostAndBullwhipAreFinite(t *testing.T) {
+	weights := DefaultCostWeights()
+
+	black := runSterman(t, BlackBoxPolicy(10, 4))
+	glass := runSterman(t, GlassBoxPolicy(decision.DefaultGlassBoxConfig()))
+
+	for _, result := range []Result{black, glass} {
+		cost := TotalCost(result, weights)
+		if cost < 0 {
+			t.Errorf("expected non-negative total cost, got %.2f", cost)
+		}
+		ratio := BullwhipRatio(result)
+		if ratio < 0 {
+			t.Errorf("expected non-negative bullwhip ratio, got %.2f", ratio)
+		}
+	}
+}